@@ -0,0 +1,49 @@
+package hangman
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+)
+
+func TestLoadRejectsMismatchedVersion(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "save.json")
+
+	// Simulate a save file written by an older or newer format, bypassing
+	// Save's stamping of the current GameStateVersion.
+	stale := `{"version":` + strconv.Itoa(GameStateVersion+1) + `,"random_word":"TEST","attempts":5}`
+	if err := os.WriteFile(path, []byte(stale), 0o644); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+
+	if _, err := Load(path); err == nil {
+		t.Error("Load() error = nil, want error for mismatched version")
+	}
+}
+
+func TestSeedReproducesInitialRevealPattern(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "save.json")
+
+	word := "GOPHER"
+	state := &GameState{
+		RandomWord:   word,
+		RevealedWord: PrintWord(word, NewRand(42)),
+		Attempts:     10,
+		Seed:         42,
+	}
+	if err := state.Save(path); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	loaded, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+
+	// The whole point of stamping Seed is that it can regenerate the same
+	// initial reveal pattern PrintWord produced when the game started.
+	if got := PrintWord(loaded.RandomWord, NewRand(loaded.Seed)); got != state.RevealedWord {
+		t.Errorf("PrintWord() with loaded.Seed = %q, want %q", got, state.RevealedWord)
+	}
+}
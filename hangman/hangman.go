@@ -0,0 +1,584 @@
+package hangman
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode"
+	"math/rand"
+
+	"github.com/peterh/liner"
+	"golang.org/x/text/unicode/norm"
+)
+
+// DisplayHangman displays the hangman ASCII art from a file based on the specified range of lines.
+// It uses ANSI escape codes to color the text blue for a visually appealing hangman display.
+func DisplayHangman(filename string, attempts int) error {
+    // Open the file containing the hangman ASCII art.
+    file, err := os.Open(filename)
+    if err != nil {
+        return err
+    }
+    defer file.Close()
+
+    // Create a scanner to read the file line by line and store each line in a slice.
+    scanner := bufio.NewScanner(file)
+    lines := make([]string, 0)
+
+    for scanner.Scan() {
+        lines = append(lines, scanner.Text())
+    }
+
+    // Return an error if there was an issue while scanning the file.
+    if scanner.Err() != nil {
+        return scanner.Err()
+    }
+
+    // Calculate the range of lines to display based on the number of incorrect attempts.
+    // Each incorrect attempt typically adds 7 lines to the hangman display.
+    startLine := attempts * 7
+    endLine := startLine + 7
+
+    // Ensure that the start and end lines are within the bounds of the available lines.
+    if startLine < 0 {
+        startLine = 0
+    }
+    if endLine > len(lines) {
+        endLine = len(lines)
+    }
+
+    // Display the selected lines in blue color using ANSI escape codes.
+    for i := startLine; i < endLine; i++ {
+        fmt.Println("\033[34m" + lines[i] + "\033[0m")
+    }
+
+    // Return nil to indicate that the function executed successfully.
+    return nil
+}
+
+// Input reads a single letter guess (or the "?" hint command) through
+// prompt, returning it as an uppercase string for consistency. Delegating
+// to prompt instead of reading bufio.Stdin directly gives callers arrow-key
+// history and editing for free when prompt is a liner-backed Prompt.
+func Input(prompt Prompt) (string, error) {
+    letter, err := prompt.ReadLetter()
+    if err != nil {
+        return "", err
+    }
+    if letter == '?' {
+        return "?", nil
+    }
+    return string(letter), nil
+}
+
+// Prompt abstracts reading a letter guess or a menu command from the
+// player, so callers can swap between a liner-backed interactive editor
+// (with history and completion) and a plain fallback when stdin is not a
+// terminal.
+type Prompt interface {
+    ReadLetter() (rune, error)
+    ReadCommand() (string, error)
+    Close() error
+}
+
+// historyFileName is where interactive session history is persisted in the
+// player's home directory, across runs.
+const historyFileName = ".hangman_history"
+
+// menuCommands lists the words the command prompt tab-completes: the real
+// commands main's loop recognizes (a blank line starts a new game and isn't
+// completable, so it is omitted here).
+var menuCommands = []string{"wordle", "save", "load", "99"}
+
+// NewPrompt returns a liner-backed Prompt when stdin is a terminal, and a
+// plain bufio-backed fallback otherwise (e.g. piped or redirected input).
+func NewPrompt() Prompt {
+    if !isTerminal(os.Stdin) {
+        return newBufioPrompt(os.Stdin)
+    }
+    return newLinerPrompt()
+}
+
+// isTerminal reports whether f is attached to an interactive terminal.
+func isTerminal(f *os.File) bool {
+    info, err := f.Stat()
+    if err != nil {
+        return false
+    }
+    return info.Mode()&os.ModeCharDevice != 0
+}
+
+// historyPath returns the path to the persisted history file in the
+// player's home directory, falling back to the bare file name if the home
+// directory cannot be determined.
+func historyPath() string {
+    home, err := os.UserHomeDir()
+    if err != nil {
+        return historyFileName
+    }
+    return filepath.Join(home, historyFileName)
+}
+
+// linerPrompt is the default Prompt implementation, backed by
+// github.com/peterh/liner for arrow-key history, backspace editing, and
+// tab completion.
+type linerPrompt struct {
+    line        *liner.State
+    historyPath string
+}
+
+func newLinerPrompt() *linerPrompt {
+    line := liner.NewLiner()
+    line.SetCtrlCAborts(true)
+    line.SetCompleter(func(input string) []string {
+        var matches []string
+        for _, c := range menuCommands {
+            if strings.HasPrefix(c, input) {
+                matches = append(matches, c)
+            }
+        }
+        return matches
+    })
+
+    p := &linerPrompt{line: line, historyPath: historyPath()}
+    if f, err := os.Open(p.historyPath); err == nil {
+        line.ReadHistory(f)
+        f.Close()
+    }
+
+    return p
+}
+
+func (p *linerPrompt) ReadLetter() (rune, error) {
+    for {
+        input, err := p.line.Prompt("Enter a single letter (or ? for a hint): ")
+        if err != nil {
+            return 0, err
+        }
+
+        input = strings.ToUpper(strings.TrimSpace(input))
+        p.line.AppendHistory(input)
+
+        if input == "?" {
+            return '?', nil
+        }
+        runes := []rune(input)
+        if len(runes) == 1 && unicode.IsLetter(runes[0]) {
+            return runes[0], nil
+        }
+
+        fmt.Println("Invalid input. Please enter a single letter.")
+    }
+}
+
+func (p *linerPrompt) ReadCommand() (string, error) {
+    input, err := p.line.Prompt("\033[31m" + "INPUT : " + "\033[0m")
+    if err != nil {
+        return "", err
+    }
+
+    input = strings.TrimSpace(input)
+    p.line.AppendHistory(input)
+    return input, nil
+}
+
+func (p *linerPrompt) Close() error {
+    if f, err := os.Create(p.historyPath); err == nil {
+        p.line.WriteHistory(f)
+        f.Close()
+    }
+    return p.line.Close()
+}
+
+// bufioPrompt is the fallback Prompt used when stdin is not a terminal, where
+// line-editing features like history and completion would be meaningless.
+type bufioPrompt struct {
+    reader *bufio.Reader
+}
+
+func newBufioPrompt(f *os.File) *bufioPrompt {
+    return &bufioPrompt{reader: bufio.NewReader(f)}
+}
+
+func (p *bufioPrompt) ReadLetter() (rune, error) {
+    for {
+        fmt.Print("Enter a single letter (or ? for a hint): ")
+
+        input, err := p.reader.ReadString('\n')
+        if err != nil {
+            return 0, err
+        }
+
+        input = strings.ToUpper(strings.TrimSpace(input))
+        if input == "?" {
+            return '?', nil
+        }
+        runes := []rune(input)
+        if len(runes) == 1 && unicode.IsLetter(runes[0]) {
+            return runes[0], nil
+        }
+
+        fmt.Println("Invalid input. Please enter a single letter.")
+    }
+}
+
+func (p *bufioPrompt) ReadCommand() (string, error) {
+    input, err := p.reader.ReadString('\n')
+    if err != nil {
+        return "", err
+    }
+    return strings.TrimSpace(input), nil
+}
+
+func (p *bufioPrompt) Close() error {
+    return nil
+}
+
+// Rand wraps math/rand.Rand so callers can inject an explicit, seedable
+// source instead of relying on the package reseeding from the current time
+// on every call. That reseeding made it impossible to reproduce a given
+// initial reveal pattern, e.g. when resuming a saved game.
+type Rand struct {
+    *rand.Rand
+}
+
+// NewRand returns a Rand seeded with seed.
+func NewRand(seed int64) *Rand {
+    return &Rand{rand.New(rand.NewSource(seed))}
+}
+
+// PrintWord is a function that reveals a random set of letters in the word at the start of the game.
+// It takes the target word and a Rand as input and returns a string with some letters revealed (randomly chosen).
+// Positions are counted by rune, not by byte, so multibyte words (accented or non-Latin scripts) reveal correctly.
+func PrintWord(word string, r *Rand) string {
+    runes := []rune(word)
+
+    // Calculate the number of letters to reveal (between 1 and len(runes)/2 - 1)
+    revealedCount := len(runes)/2 - 1
+
+    // Generate a random set of indices to reveal
+    revealedIndices := make([]int, revealedCount)
+    for i := 0; i < revealedCount; i++ {
+        randomIndex := r.Intn(len(runes))
+        revealedIndices[i] = randomIndex
+    }
+
+    var str strings.Builder
+
+    for i, r := range runes {
+        revealed := false
+        for _, index := range revealedIndices {
+            if i == index {
+                str.WriteRune(r)
+                revealed = true
+                break
+            }
+        }
+        if !revealed {
+            str.WriteRune('_')
+        }
+    }
+
+    return str.String()
+}
+
+// RevealLetters is a function responsible for revealing specific letters in the word.
+// It takes the target word, a list of indices to reveal, and the current state of the revealed word.
+// It updates the revealed word based on the provided indices and returns the updated revealed word.
+func RevealLetters(word string, indices []int, revealedWord string) string {
+    revealed := []rune(revealedWord) // Convert the revealed word to a rune slice for modification
+    WordTab := []rune(word) // Convert the target word to a rune slice for access
+
+    // Iterate through the provided indices and update the revealed word
+    for _, index := range indices {
+        if index >= 0 && index < len(WordTab) {
+            revealed[index] = WordTab[index]
+        }
+    }
+
+    return string(revealed) // Convert the updated revealed word back to a string
+}
+
+// Start function is responsible for displaying the initial hangman or game-related content
+// from a specified file. It uses ANSI escape codes to apply red color for a visual effect.
+// It takes the name of the file containing the content to display as an argument.
+
+func Start(filename string) error {
+    file, err := os.Open(filename) // Open the specified file.
+    if err != nil {
+        return err
+    }
+    defer file.Close()
+
+    scanner := bufio.NewScanner(file)
+    lines := make([]string, 0)
+
+    // Read the content of the file line by line and store each line in a slice.
+    for scanner.Scan() {
+        lines = append(lines, scanner.Text())
+    }
+
+    // Return an error if there's an issue while scanning the file.
+    if scanner.Err() != nil {
+        return scanner.Err()
+    }
+
+    // Display the first 16 lines of the content using red color (ANSI escape codes).
+    for i := 0; i < 16; i++ {
+        fmt.Println("\033[31m" + lines[i] + "\033[0m")
+    }
+
+    return nil
+}
+
+// FoldDiacritics controls whether letter comparisons in Verify treat
+// accented letters as equivalent to their bare counterpart, e.g. a guess of
+// "E" matching "É". Disabled by default so exact Unicode matches are
+// required, matching the historical ASCII-only behavior.
+var FoldDiacritics = false
+
+// foldLetter uppercases r and, when FoldDiacritics is enabled, strips any
+// Unicode combining marks via NFD decomposition so accented letters compare
+// equal to their unaccented base letter.
+func foldLetter(r rune) rune {
+    r = unicode.ToUpper(r)
+    if !FoldDiacritics {
+        return r
+    }
+
+    for _, dr := range norm.NFD.String(string(r)) {
+        if !unicode.Is(unicode.Mn, dr) {
+            return dr
+        }
+    }
+    return r
+}
+
+// Verify is a function that checks if a letter is present in the target word.
+// It takes the target word and a letter as input and returns a slice of indices
+// where the letter is found in the word. If the letter is not found, it returns nil.
+// Comparison is done rune by rune, with FoldDiacritics controlling whether
+// accents are folded away before matching.
+
+func Verify(word, letter string) []int {
+    WordTab := []rune(word)      // Convert the target word to a rune slice for character comparison
+    RuneLetter := []rune(letter) // Convert the input letter to a rune slice for comparison
+    var indices []int            // Initialize a slice to store indices where the letter is found
+
+    target := foldLetter(RuneLetter[0])
+
+    // Iterate through the target word to find occurrences of the input letter
+    for i := 0; i < len(WordTab); i++ {
+        if target == foldLetter(WordTab[i]) {
+            indices = append(indices, i) // Add the index to the slice if the letter is found
+        }
+    }
+
+    // If no occurrences of the letter are found, return nil
+    if len(indices) == 0 {
+        return nil
+    }
+
+    return indices
+}
+
+// WordList is a function that returns a random word from a text file or an error if any occurs.
+// It takes the name of the text file and a Rand as arguments, reads the list of words from the
+// file, and selects a random word from the list using r.
+
+func WordList(textFile string, r *Rand) (string, error) {
+    // Open the text file for reading
+    file, err := os.Open(textFile)
+    if err != nil {
+        return "", err
+    }
+    defer file.Close()
+
+    // Read the words from the file and store them in a slice
+    var wordList []string
+    scanner := bufio.NewScanner(file)
+    for scanner.Scan() {
+        wordList = append(wordList, scanner.Text())
+    }
+
+    // Return an error if there's an issue while scanning the file
+    if scanner.Err() != nil {
+        return "", scanner.Err()
+    }
+
+    // Select a random word from the list
+    randomIndex := r.Intn(len(wordList))
+    randomWord := wordList[randomIndex]
+
+    return randomWord, nil
+}
+
+// WordListFiltered reads every word from path whose rune length is within
+// [minLen, maxLen] and for which predicate returns true for every rune,
+// so callers can load themed or length-constrained dictionaries (e.g.
+// unicode.IsLetter to admit any alphabet, or a tighter custom check).
+func WordListFiltered(path string, minLen, maxLen int, predicate func(rune) bool) ([]string, error) {
+    file, err := os.Open(path)
+    if err != nil {
+        return nil, err
+    }
+    defer file.Close()
+
+    var words []string
+    scanner := bufio.NewScanner(file)
+    for scanner.Scan() {
+        word := scanner.Text()
+        runes := []rune(word)
+
+        if len(runes) < minLen || len(runes) > maxLen {
+            continue
+        }
+
+        ok := true
+        for _, r := range runes {
+            if !predicate(r) {
+                ok = false
+                break
+            }
+        }
+        if ok {
+            words = append(words, word)
+        }
+    }
+
+    if scanner.Err() != nil {
+        return nil, scanner.Err()
+    }
+
+    return words, nil
+}
+
+// Feedback represents the result of comparing a single guessed letter
+// against the target word at a given position in Wordle-style play.
+type Feedback int
+
+const (
+    // Absent means the guessed letter does not occur in the target word.
+    Absent Feedback = iota
+    // Present means the guessed letter occurs in the target word but at a different position.
+    Present
+    // Correct means the guessed letter occurs at this exact position in the target word.
+    Correct
+)
+
+// DefaultWordleAttempts is the number of whole-word guesses allowed in
+// Wordle mode before the game is lost.
+const DefaultWordleAttempts = 6
+
+// EvaluateGuess compares guess against target, both expected to be the same
+// length, and returns per-position feedback. It uses the standard two-pass
+// algorithm: the first pass marks exact-position matches and removes them
+// from a per-letter remaining count built from the target, and the second
+// pass walks the non-matching positions, marking a letter Present only
+// while its remaining count is still greater than zero.
+func EvaluateGuess(target, guess string) []Feedback {
+    targetRunes := []rune(target)
+    guessRunes := []rune(guess)
+
+    feedback := make([]Feedback, len(guessRunes))
+    remaining := make(map[rune]int)
+
+    // Pass 1: mark exact-position matches and tally the leftover letters.
+    for i, r := range guessRunes {
+        if i < len(targetRunes) && r == targetRunes[i] {
+            feedback[i] = Correct
+        } else if i < len(targetRunes) {
+            remaining[targetRunes[i]]++
+        }
+    }
+
+    // Pass 2: mark remaining letters Present while the target still has an
+    // unmatched occurrence, Absent otherwise.
+    for i, r := range guessRunes {
+        if feedback[i] == Correct {
+            continue
+        }
+        if remaining[r] > 0 {
+            feedback[i] = Present
+            remaining[r]--
+        } else {
+            feedback[i] = Absent
+        }
+    }
+
+    return feedback
+}
+
+// RenderGuess prints guess with per-position coloring derived from feedback:
+// a green background for Correct, yellow for Present, and gray for Absent,
+// using the same raw ANSI escape codes as the rest of the package.
+func RenderGuess(guess string, feedback []Feedback) {
+    for i, r := range []rune(guess) {
+        switch feedback[i] {
+        case Correct:
+            fmt.Print("\033[42m" + string(r) + "\033[0m")
+        case Present:
+            fmt.Print("\033[43m" + string(r) + "\033[0m")
+        default:
+            fmt.Print("\033[100m" + string(r) + "\033[0m")
+        }
+    }
+    fmt.Println()
+}
+
+// GameStateVersion is the schema version written by GameState.Save and
+// checked by Load, so a future format change can be detected on reload.
+const GameStateVersion = 1
+
+// GameState captures everything needed to resume an in-progress classic
+// hangman round: the target word, its current reveal state, attempts
+// remaining, the number of wrong guesses (to redraw the hangman art at the
+// right step), the letters tried so far, and the seed used to generate the
+// initial reveal pattern.
+type GameState struct {
+    Version      int    `json:"version"`
+    RandomWord   string `json:"random_word"`
+    RevealedWord string `json:"revealed_word"`
+    Attempts     int    `json:"attempts"`
+    Steps        int    `json:"steps"`
+    UsedTrue     string `json:"used_true"`
+    UsedFalse    string `json:"used_false"`
+    Seed         int64  `json:"seed"`
+}
+
+// Save marshals g to path as indented JSON, stamping it with the current
+// schema version.
+func (g *GameState) Save(path string) error {
+    g.Version = GameStateVersion
+
+    data, err := json.MarshalIndent(g, "", "  ")
+    if err != nil {
+        return err
+    }
+
+    return os.WriteFile(path, data, 0o644)
+}
+
+// Load reads and unmarshals a GameState previously written by Save,
+// rejecting a save file whose version does not match GameStateVersion so a
+// stale or hand-edited format is never silently loaded.
+func Load(path string) (*GameState, error) {
+    data, err := os.ReadFile(path)
+    if err != nil {
+        return nil, err
+    }
+
+    var g GameState
+    if err := json.Unmarshal(data, &g); err != nil {
+        return nil, err
+    }
+
+    if g.Version != GameStateVersion {
+        return nil, fmt.Errorf("save file %s has version %d, want %d", path, g.Version, GameStateVersion)
+    }
+
+    return &g, nil
+}
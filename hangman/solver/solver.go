@@ -0,0 +1,142 @@
+package solver
+
+// FilterCandidates returns the words from dict that are the same length as
+// revealed, match every already-revealed position (an underscore in
+// revealed means the position is still unknown), and contain none of the
+// letters in wrong.
+func FilterCandidates(revealed string, wrong []rune, dict []string) []string {
+	revealedRunes := []rune(revealed)
+
+	wrongSet := make(map[rune]bool, len(wrong))
+	for _, r := range wrong {
+		wrongSet[r] = true
+	}
+
+	var candidates []string
+	for _, word := range dict {
+		wordRunes := []rune(word)
+		if len(wordRunes) != len(revealedRunes) {
+			continue
+		}
+		if !matchesRevealed(wordRunes, revealedRunes) {
+			continue
+		}
+		if containsAny(wordRunes, wrongSet) {
+			continue
+		}
+		candidates = append(candidates, word)
+	}
+
+	return candidates
+}
+
+// matchesRevealed reports whether word agrees with every non-underscore
+// position in revealed.
+func matchesRevealed(word, revealed []rune) bool {
+	for i, r := range revealed {
+		if r == '_' {
+			continue
+		}
+		if word[i] != r {
+			return false
+		}
+	}
+	return true
+}
+
+// containsAny reports whether word contains any rune present in set.
+func containsAny(word []rune, set map[rune]bool) bool {
+	for _, r := range word {
+		if set[r] {
+			return true
+		}
+	}
+	return false
+}
+
+// ScoreWords scores each candidate word by the positional letter frequency
+// across cands: for every position p, freq[p][r] is the number of
+// candidates with rune r at p, and a word's score is the sum of
+// freq[p][word[p]] over its positions. Words built from more common letters
+// in more common positions score higher.
+func ScoreWords(cands []string) map[string]int {
+	freq := positionalFrequency(cands)
+
+	scores := make(map[string]int, len(cands))
+	for _, word := range cands {
+		scores[word] = scoreWord([]rune(word), freq)
+	}
+
+	return scores
+}
+
+// SuggestLetter returns the unguessed letter with the highest positional
+// frequency score (see ScoreWords) across cands, breaking ties by the
+// letter's total occurrence count across all candidates and positions.
+func SuggestLetter(revealed string, guessed map[rune]bool, cands []string) rune {
+	freq := positionalFrequency(cands)
+
+	var best rune
+	bestScore, bestTotal := -1, -1
+	for r, total := range totalOccurrences(cands) {
+		if guessed[r] {
+			continue
+		}
+		score := 0
+		for p := range freq {
+			score += freq[p][r]
+		}
+		if score > bestScore || (score == bestScore && total > bestTotal) {
+			best = r
+			bestScore = score
+			bestTotal = total
+		}
+	}
+
+	return best
+}
+
+// positionalFrequency builds freq[p][r], the number of cands with rune r at
+// position p.
+func positionalFrequency(cands []string) []map[rune]int {
+	maxLen := 0
+	for _, word := range cands {
+		if l := len([]rune(word)); l > maxLen {
+			maxLen = l
+		}
+	}
+
+	freq := make([]map[rune]int, maxLen)
+	for i := range freq {
+		freq[i] = make(map[rune]int)
+	}
+
+	for _, word := range cands {
+		for p, r := range []rune(word) {
+			freq[p][r]++
+		}
+	}
+
+	return freq
+}
+
+// totalOccurrences counts how many times each rune appears across all
+// positions of all cands, regardless of position.
+func totalOccurrences(cands []string) map[rune]int {
+	totals := make(map[rune]int)
+	for _, word := range cands {
+		for _, r := range word {
+			totals[r]++
+		}
+	}
+	return totals
+}
+
+// scoreWord sums freq[p][word[p]] over every position of word.
+func scoreWord(word []rune, freq []map[rune]int) int {
+	score := 0
+	for p, r := range word {
+		score += freq[p][r]
+	}
+	return score
+}
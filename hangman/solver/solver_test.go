@@ -0,0 +1,46 @@
+package solver
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFilterCandidatesRevealedPositions(t *testing.T) {
+	dict := []string{"APPLE", "ANGLE", "AISLE", "TABLE"}
+
+	got := FilterCandidates("A____", nil, dict)
+	want := []string{"APPLE", "ANGLE", "AISLE"}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("FilterCandidates() = %v, want %v", got, want)
+	}
+}
+
+func TestFilterCandidatesExcludesWrongLetters(t *testing.T) {
+	dict := []string{"APPLE", "ANGLE", "AISLE"}
+
+	got := FilterCandidates("A____", []rune{'P'}, dict)
+	want := []string{"ANGLE", "AISLE"}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("FilterCandidates() = %v, want %v", got, want)
+	}
+
+	for _, word := range got {
+		for _, r := range word {
+			if r == 'P' {
+				t.Errorf("candidate %q contains excluded letter P", word)
+			}
+		}
+	}
+}
+
+func TestSuggestLetterIgnoresGuessed(t *testing.T) {
+	cands := []string{"ANGLE", "AISLE", "ANKLE"}
+	guessed := map[rune]bool{'A': true}
+
+	got := SuggestLetter("A____", guessed, cands)
+	if got == 'A' {
+		t.Errorf("SuggestLetter() returned already-guessed letter %q", got)
+	}
+}
@@ -0,0 +1,87 @@
+package hangman
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"unicode"
+)
+
+func writeWordFile(t *testing.T, words ...string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "words.txt")
+	content := ""
+	for _, w := range words {
+		content += w + "\n"
+	}
+
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+
+	return path
+}
+
+func TestWordListFilteredFrenchAccents(t *testing.T) {
+	path := writeWordFile(t, "ÉTÉ", "NOËL", "AMI", "CAFÉ")
+
+	got, err := WordListFiltered(path, 3, 4, unicode.IsLetter)
+	if err != nil {
+		t.Fatalf("WordListFiltered() error = %v", err)
+	}
+
+	want := []string{"ÉTÉ", "NOËL", "AMI", "CAFÉ"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("WordListFiltered() = %v, want %v", got, want)
+	}
+}
+
+func TestWordListFilteredCyrillic(t *testing.T) {
+	path := writeWordFile(t, "ПРИВЕТ", "МИР", "ДА")
+
+	got, err := WordListFiltered(path, 3, 10, unicode.IsLetter)
+	if err != nil {
+		t.Fatalf("WordListFiltered() error = %v", err)
+	}
+
+	want := []string{"ПРИВЕТ", "МИР"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("WordListFiltered() = %v, want %v", got, want)
+	}
+}
+
+func TestVerifyFoldsDiacriticsWhenEnabled(t *testing.T) {
+	FoldDiacritics = true
+	defer func() { FoldDiacritics = false }()
+
+	if indices := Verify("CAFÉ", "E"); !reflect.DeepEqual(indices, []int{3}) {
+		t.Errorf("Verify() = %v, want [3]", indices)
+	}
+}
+
+func TestVerifyRequiresExactMatchByDefault(t *testing.T) {
+	if indices := Verify("CAFÉ", "E"); indices != nil {
+		t.Errorf("Verify() = %v, want nil", indices)
+	}
+}
+
+func TestPrintWordRevealsByRunePosition(t *testing.T) {
+	word := "NAÏVE" // a multibyte rune (Ï) before the end, so byte and rune
+	// positions diverge; PrintWord must index by rune, not by byte.
+	wordRunes := []rune(word)
+
+	got := PrintWord(word, NewRand(1))
+	gotRunes := []rune(got)
+
+	if len(gotRunes) != len(wordRunes) {
+		t.Fatalf("PrintWord() rune length = %d, want %d", len(gotRunes), len(wordRunes))
+	}
+
+	for i, r := range gotRunes {
+		if r != '_' && r != wordRunes[i] {
+			t.Errorf("PrintWord() rune %d = %q, want %q or '_'", i, r, wordRunes[i])
+		}
+	}
+}
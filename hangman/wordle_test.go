@@ -0,0 +1,33 @@
+package hangman
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestEvaluateGuessAllCorrect(t *testing.T) {
+	got := EvaluateGuess("CRANE", "CRANE")
+	want := []Feedback{Correct, Correct, Correct, Correct, Correct}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("EvaluateGuess() = %v, want %v", got, want)
+	}
+}
+
+func TestEvaluateGuessAllAbsent(t *testing.T) {
+	got := EvaluateGuess("CRANE", "GHOST")
+	want := []Feedback{Absent, Absent, Absent, Absent, Absent}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("EvaluateGuess() = %v, want %v", got, want)
+	}
+}
+
+func TestEvaluateGuessDuplicateLetters(t *testing.T) {
+	// target SPEED has two Es and one S; guess ERASE has two Es and one S,
+	// none in a matching position, so the two-pass algorithm marks both Es
+	// and the S Present, while R and A, absent from the target, are Absent.
+	got := EvaluateGuess("SPEED", "ERASE")
+	want := []Feedback{Present, Absent, Absent, Present, Present}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("EvaluateGuess() = %v, want %v", got, want)
+	}
+}
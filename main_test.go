@@ -0,0 +1,28 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+// TestWordDictionary also exercises that main.go's solver and hangman
+// imports resolve and link correctly now that the module has a go.mod.
+func TestWordDictionary(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "words.txt")
+	content := "APPLE\nMANGO\nKIWI\n"
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+
+	got, err := wordDictionary(path)
+	if err != nil {
+		t.Fatalf("wordDictionary() error = %v", err)
+	}
+
+	want := []string{"APPLE", "MANGO", "KIWI"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("wordDictionary() = %v, want %v", got, want)
+	}
+}